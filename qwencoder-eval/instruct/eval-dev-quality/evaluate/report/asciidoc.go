@@ -0,0 +1,118 @@
+package report
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/zimmski/osutil/bytesutil"
+
+	"github.com/symflower/eval-dev-quality/evaluate/metrics"
+)
+
+// AsciiDocRenderer renders an evaluation Context as AsciiDoc.
+type AsciiDocRenderer struct {
+	Context
+}
+
+// asciiDocTemplateContext holds the data exposed to the AsciiDoc report template.
+type asciiDocTemplateContext struct {
+	Context
+
+	Categories        []*metrics.AssessmentCategory
+	ModelsPerCategory map[*metrics.AssessmentCategory][]string
+}
+
+// ModelLogName formats a model name to match the logging structure.
+func (c asciiDocTemplateContext) ModelLogName(modelName string) string {
+	return MarkdownTemplateContext(c).ModelLogName(modelName)
+}
+
+// asciiDocTemplate holds the template for an AsciiDoc report.
+var asciiDocTemplate = template.Must(template.New("template-report-asciidoc").Parse(bytesutil.StringTrimIndentations(`
+	= Evaluation from {{.DateTime.Format "2006-01-02 15:04:05"}}
+
+	image::{{.SVGPath}}[Bar chart that categorizes all evaluated models.]
+
+	{{ if .PerModelChartPath }}image::{{.PerModelChartPath}}[Bar chart of the total score per model.]
+	{{ end }}
+	{{ if .StackedChartPath }}image::{{.StackedChartPath}}[Stacked bar chart of the per-model score breakdown.]
+	{{ end }}
+	{{ if .HeatmapChartPath }}image::{{.HeatmapChartPath}}[Heatmap of models and assessment keys, colored by score.]
+	{{ end }}
+	This report was generated by the https://github.com/symflower/eval-dev-quality[DevQualityEval benchmark] in version ` + "`" + `{{.Version}}` + "`" + ` - revision ` + "`" + `{{.Revision}}` + "`" + `.
+
+	== Results
+
+	CAUTION: Keep in mind that LLMs are nondeterministic. The following results just reflect a current snapshot.
+
+	The results of all models have been divided into the following categories:
+	{{ range $category := .Categories -}}
+	* {{ $category.Name }}: {{ $category.Description }}
+	{{ end }}
+	The following sections list all models with their categories. Detailed scoring can be found link:{{.CSVPath}}[here]. The complete log of the evaluation with all outputs can be found here:{{ range .LogPaths }}
+	* {{.}}{{ end }}
+
+	{{ range $category := .Categories -}}
+	{{ with $modelNames := index $.ModelsPerCategory $category -}}
+	=== Result category "{{ $category.Name }}"
+
+	{{ $category.Description }}
+
+	{{ range $modelName := $modelNames -}}
+	* link:{{ $.ModelLogName $modelName }}[` + "`" + `{{ $modelName }}` + "`" + `]
+	{{ end }}
+	{{ end }}
+	{{- end -}}
+`)))
+
+// Render writes the rendered AsciiDoc report for the given context to the writer.
+func (a *AsciiDocRenderer) Render(writer io.Writer, context Context) (err error) {
+	templateContext := asciiDocTemplateContext{
+		Context:    context,
+		Categories: metrics.AllAssessmentCategories,
+	}
+	templateContext.ModelsPerCategory = context.modelsPerCategory()
+
+	if err := asciiDocTemplate.Execute(writer, templateContext); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	return nil
+}
+
+// WriteToFile renders the AsciiDoc to the given file.
+func (a *AsciiDocRenderer) WriteToFile(path string) (err error) {
+	directoryPath := filepath.Dir(path)
+	if err = os.MkdirAll(directoryPath, 0755); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	if err := writeCategoryChart(a.Context, directoryPath); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return pkgerrors.WithStack(err)
+	}
+	defer func() {
+		if e := file.Close(); e != nil {
+			e = pkgerrors.WithStack(e)
+			if err == nil {
+				err = e
+			} else {
+				err = errors.Join(err, e)
+			}
+		}
+	}()
+
+	if err := a.Render(file, a.Context); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package report
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/symflower/eval-dev-quality/evaluate/metrics"
+)
+
+func TestAsciiDocRendererRender(t *testing.T) {
+	category := &metrics.AssessmentCategory{Name: "gold", Description: "gold category"}
+	originalCategories := metrics.AllAssessmentCategories
+	metrics.AllAssessmentCategories = []*metrics.AssessmentCategory{category}
+	defer func() {
+		metrics.AllAssessmentCategories = originalCategories
+	}()
+
+	context := Context{
+		Version:           "1.2.3",
+		Revision:          "abc",
+		SVGPath:           "chart.svg",
+		PerModelChartPath: "per-model.svg",
+		StackedChartPath:  "stacked.svg",
+		HeatmapChartPath:  "heatmap.svg",
+		AssessmentPerModel: AssessmentPerModel{
+			"model-a": metrics.Assessment{metrics.AssessmentKey("compilation"): 5},
+		},
+		TotalScore: 10,
+	}
+
+	renderer := &AsciiDocRenderer{}
+
+	var buffer bytes.Buffer
+	require.NoError(t, renderer.Render(&buffer, context))
+
+	rendered := buffer.String()
+	assert.Contains(t, rendered, "image::chart.svg[")
+	assert.Contains(t, rendered, "image::per-model.svg[")
+	assert.Contains(t, rendered, "image::stacked.svg[")
+	assert.Contains(t, rendered, "image::heatmap.svg[")
+	assert.Contains(t, rendered, "version `1.2.3`")
+	assert.Contains(t, rendered, "model-a")
+	assert.Contains(t, rendered, `=== Result category "gold"`)
+}
@@ -0,0 +1,256 @@
+package report
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"sort"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/wcharczuk/go-chart/v2"
+
+	"github.com/symflower/eval-dev-quality/evaluate/metrics"
+)
+
+// Charts exposes the richer, at-a-glance charts derived from an evaluation Context, beyond the single categories-count bar chart.
+type Charts struct {
+	// Context holds the evaluation data the charts are derived from.
+	Context Context
+}
+
+// PerModelScoreChart renders a bar chart of per-model total scores, sorted descending.
+type PerModelScoreChart struct {
+	Context Context
+}
+
+// PerModelScore returns the per-model total score chart for this Context.
+func (c Charts) PerModelScore() PerModelScoreChart {
+	return PerModelScoreChart{Context: c.Context}
+}
+
+// modelScores returns the models of the context sorted by descending total score.
+func modelScores(context Context) []chart.Value {
+	scores := make([]chart.Value, 0, len(context.AssessmentPerModel))
+	for model, assessment := range context.AssessmentPerModel {
+		scores = append(scores, chart.Value{
+			Label: model,
+			Value: float64(assessment.Score()),
+		})
+	}
+	sort.Slice(scores, func(i, j int) bool {
+		return scores[i].Value > scores[j].Value
+	})
+
+	return scores
+}
+
+// WriteTo writes the rendered SVG of this chart to the writer.
+func (c PerModelScoreChart) WriteTo(writer io.Writer) (n int64, err error) {
+	scores := modelScores(c.Context)
+
+	counter := &writeCounter{writer: writer}
+	if len(scores) == 0 {
+		return counter.n, nil
+	}
+
+	graph := chart.BarChart{
+		Title: "Total Score per Model",
+		Bars:  scores,
+		Background: chart.Style{
+			Padding: chart.Box{
+				Top:    60,
+				Bottom: 80,
+			},
+		},
+		Height:   300,
+		Width:    (len(scores) + 2) * 60,
+		BarWidth: 40,
+	}
+
+	if err := graph.Render(chart.SVG, counter); err != nil {
+		return counter.n, pkgerrors.WithStack(err)
+	}
+
+	return counter.n, nil
+}
+
+// StackedCategoryChart renders, for every model, a stacked bar showing how much each metrics.AssessmentKey contributed to its total score.
+type StackedCategoryChart struct {
+	Context Context
+}
+
+// StackedCategoryBreakdown returns the per-model, per-assessment-key stacked chart for this Context.
+func (c Charts) StackedCategoryBreakdown() StackedCategoryChart {
+	return StackedCategoryChart{Context: c.Context}
+}
+
+// WriteTo writes the rendered SVG of this chart to the writer.
+func (c StackedCategoryChart) WriteTo(writer io.Writer) (n int64, err error) {
+	counter := &writeCounter{writer: writer}
+	if len(c.Context.AssessmentPerModel) == 0 {
+		return counter.n, nil
+	}
+
+	models := make([]string, 0, len(c.Context.AssessmentPerModel))
+	for model := range c.Context.AssessmentPerModel {
+		models = append(models, model)
+	}
+	sort.Strings(models)
+
+	bars := make([]chart.StackedBar, 0, len(models))
+	for _, model := range models {
+		assessment := c.Context.AssessmentPerModel[model]
+
+		keys := make([]metrics.AssessmentKey, 0, len(assessment))
+		for key := range assessment {
+			keys = append(keys, key)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			return keys[i] < keys[j]
+		})
+
+		values := make([]chart.Value, 0, len(keys))
+		for _, key := range keys {
+			values = append(values, chart.Value{
+				Label: string(key),
+				Value: float64(assessment[key]),
+			})
+		}
+
+		bars = append(bars, chart.StackedBar{
+			Name:   model,
+			Values: values,
+		})
+	}
+
+	graph := chart.StackedBarChart{
+		Title: "Per-Model Score Breakdown",
+		Bars:  bars,
+		Background: chart.Style{
+			Padding: chart.Box{
+				Top:    60,
+				Bottom: 40,
+			},
+		},
+		Height: 300,
+		Width:  (len(bars) + 2) * 80,
+	}
+
+	if err := graph.Render(chart.SVG, counter); err != nil {
+		return counter.n, pkgerrors.WithStack(err)
+	}
+
+	return counter.n, nil
+}
+
+// HeatmapChart renders a heatmap colored by each model's relative performance per metrics.AssessmentKey.
+//
+// Note: this buckets columns by metrics.AssessmentKey rather than by individual task, since Context carries no
+// per-task breakdown, only the per-key totals stored in a model's metrics.Assessment.
+type HeatmapChart struct {
+	Context Context
+}
+
+// Heatmap returns the models×assessment-keys heatmap chart for this Context.
+func (c Charts) Heatmap() HeatmapChart {
+	return HeatmapChart{Context: c.Context}
+}
+
+// heatmapColor maps a score share in [0, 1] to a green intensity, from pale (low) to saturated (high).
+func heatmapColor(share float64) string {
+	if share < 0 {
+		share = 0
+	} else if share > 1 {
+		share = 1
+	}
+
+	intensity := 255 - int(share*200)
+
+	return fmt.Sprintf("rgb(%d,%d,%d)", intensity, 255-int(share*60), intensity)
+}
+
+// WriteTo writes the rendered SVG of this heatmap to the writer. go-chart has no heatmap chart type, so the grid is emitted directly as SVG rects.
+func (c HeatmapChart) WriteTo(writer io.Writer) (n int64, err error) {
+	counter := &writeCounter{writer: writer}
+	if len(c.Context.AssessmentPerModel) == 0 {
+		return counter.n, nil
+	}
+
+	models := make([]string, 0, len(c.Context.AssessmentPerModel))
+	keySet := make(map[metrics.AssessmentKey]struct{})
+	for model, assessment := range c.Context.AssessmentPerModel {
+		models = append(models, model)
+		for key := range assessment {
+			keySet[key] = struct{}{}
+		}
+	}
+	sort.Strings(models)
+
+	keys := make([]metrics.AssessmentKey, 0, len(keySet))
+	for key := range keySet {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i] < keys[j]
+	})
+
+	// Normalize each column against that key's own maximum observed value, not the grand total across all keys -
+	// otherwise a single key's score is washed out or saturated depending on how many other keys roll into the total.
+	maxPerKey := make(map[metrics.AssessmentKey]uint64, len(keys))
+	for _, model := range models {
+		assessment := c.Context.AssessmentPerModel[model]
+		for _, key := range keys {
+			if value := assessment[key]; value > maxPerKey[key] {
+				maxPerKey[key] = value
+			}
+		}
+	}
+
+	const (
+		cellWidth   = 90
+		cellHeight  = 24
+		labelWidth  = 160
+		labelHeight = 40
+	)
+	width := labelWidth + cellWidth*len(keys)
+	height := labelHeight + cellHeight*len(models)
+
+	fmt.Fprintf(counter, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" font-family="sans-serif" font-size="11">`, width, height)
+	for column, key := range keys {
+		x := labelWidth + column*cellWidth
+		fmt.Fprintf(counter, `<text x="%d" y="%d" text-anchor="middle">%s</text>`, x+cellWidth/2, labelHeight-10, html.EscapeString(string(key)))
+	}
+	for row, model := range models {
+		y := labelHeight + row*cellHeight
+		fmt.Fprintf(counter, `<text x="%d" y="%d">%s</text>`, 0, y+cellHeight/2+4, html.EscapeString(model))
+
+		assessment := c.Context.AssessmentPerModel[model]
+		for column, key := range keys {
+			x := labelWidth + column*cellWidth
+			var share float64
+			if max := maxPerKey[key]; max > 0 {
+				share = float64(assessment[key]) / float64(max)
+			}
+
+			fmt.Fprintf(counter, `<rect x="%d" y="%d" width="%d" height="%d" fill="%s" /><text x="%d" y="%d" text-anchor="middle">%d</text>`,
+				x, y, cellWidth-2, cellHeight-2, heatmapColor(share), x+cellWidth/2, y+cellHeight/2+4, assessment[key])
+		}
+	}
+	fmt.Fprint(counter, `</svg>`)
+
+	return counter.n, nil
+}
+
+// writeCounter wraps an io.Writer to track the number of bytes written, satisfying the io.WriterTo return value of the chart types above.
+type writeCounter struct {
+	writer io.Writer
+	n      int64
+}
+
+// Write implements io.Writer.
+func (w *writeCounter) Write(p []byte) (written int, err error) {
+	written, err = w.writer.Write(p)
+	w.n += int64(written)
+
+	return written, err
+}
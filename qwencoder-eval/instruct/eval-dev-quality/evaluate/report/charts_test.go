@@ -0,0 +1,89 @@
+package report
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/symflower/eval-dev-quality/evaluate/metrics"
+)
+
+func TestHeatmapColor(t *testing.T) {
+	assert.Equal(t, heatmapColor(0), heatmapColor(-1), "shares below 0 should clamp to 0")
+	assert.Equal(t, heatmapColor(1), heatmapColor(2), "shares above 1 should clamp to 1")
+	assert.NotEqual(t, heatmapColor(0), heatmapColor(1), "a fully saturated cell should differ from an empty one")
+}
+
+func TestHeatmapChartNormalizesPerKeyNotGrandTotal(t *testing.T) {
+	context := Context{
+		TotalScore: 1000, // a large grand total that a per-key share must not be divided by
+		AssessmentPerModel: AssessmentPerModel{
+			"model-a": metrics.Assessment{
+				metrics.AssessmentKey("rare-key"):   1,
+				metrics.AssessmentKey("common-key"): 10,
+			},
+			"model-b": metrics.Assessment{
+				metrics.AssessmentKey("rare-key"):   1,
+				metrics.AssessmentKey("common-key"): 5,
+			},
+		},
+	}
+
+	var buffer bytes.Buffer
+	_, err := HeatmapChart{Context: context}.WriteTo(&buffer)
+	require.NoError(t, err)
+
+	// "rare-key" maxes out at 1 for both models, so each should render as the fully saturated color, exactly like
+	// "model-a"'s "common-key" cell which also equals its own column max (10). If the grand total were used instead,
+	// "rare-key" cells (1/1000) would render as nearly empty instead of saturated.
+	saturated := heatmapColor(1)
+	assert.Equal(t, 3, strings.Count(buffer.String(), `fill="`+saturated+`"`))
+}
+
+func TestHeatmapChartEscapesModelAndKeyNames(t *testing.T) {
+	context := Context{
+		TotalScore: 10,
+		AssessmentPerModel: AssessmentPerModel{
+			"<model> & co": metrics.Assessment{
+				metrics.AssessmentKey("a<b>"): 5,
+			},
+		},
+	}
+
+	var buffer bytes.Buffer
+	_, err := HeatmapChart{Context: context}.WriteTo(&buffer)
+	require.NoError(t, err)
+
+	rendered := buffer.String()
+	assert.NotContains(t, rendered, "<model> & co")
+	assert.NotContains(t, rendered, "a<b>")
+	assert.Contains(t, rendered, "&lt;model&gt; &amp; co")
+	assert.Contains(t, rendered, "a&lt;b&gt;")
+}
+
+func TestHeatmapChartEmptyContext(t *testing.T) {
+	var buffer bytes.Buffer
+	n, err := HeatmapChart{Context: Context{}}.WriteTo(&buffer)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+	assert.Empty(t, buffer.String())
+}
+
+func TestModelScoresSortedDescending(t *testing.T) {
+	context := Context{
+		AssessmentPerModel: AssessmentPerModel{
+			"low":  metrics.Assessment{metrics.AssessmentKey("k"): 1},
+			"high": metrics.Assessment{metrics.AssessmentKey("k"): 9},
+			"mid":  metrics.Assessment{metrics.AssessmentKey("k"): 5},
+		},
+	}
+
+	scores := modelScores(context)
+	require.Len(t, scores, 3)
+	assert.Equal(t, "high", scores[0].Label)
+	assert.Equal(t, "mid", scores[1].Label)
+	assert.Equal(t, "low", scores[2].Label)
+}
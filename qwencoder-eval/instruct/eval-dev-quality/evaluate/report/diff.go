@@ -0,0 +1,395 @@
+package report
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"text/template"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/wcharczuk/go-chart/v2"
+	"github.com/zimmski/osutil/bytesutil"
+
+	"github.com/symflower/eval-dev-quality/evaluate/metrics"
+)
+
+// Diff compares a baseline evaluation run against the current one, highlighting regressions and improvements between the two. This allows wiring evaluation runs into CI as a regression gate.
+type Diff struct {
+	// Baseline holds the context of the evaluation run being compared against.
+	Baseline Context
+	// Current holds the context of the evaluation run being checked.
+	Current Context
+
+	// SVGPath holds the path of the baseline-vs-current comparison chart.
+	SVGPath string
+}
+
+// ModelDelta holds how a single model's score and category changed between the baseline and the current evaluation run.
+type ModelDelta struct {
+	// Model holds the name of the model.
+	Model string `json:"model"`
+	// BaselineScore holds the model's total score in the baseline run, or 0 if the model is new.
+	BaselineScore uint64 `json:"baseline-score"`
+	// CurrentScore holds the model's total score in the current run, or 0 if the model was removed.
+	CurrentScore uint64 `json:"current-score"`
+	// Delta holds the signed difference between CurrentScore and BaselineScore.
+	Delta int64 `json:"delta"`
+	// BaselineCategory holds the name of the category the model fell into in the baseline run, empty if the model is new.
+	BaselineCategory string `json:"baseline-category"`
+	// CurrentCategory holds the name of the category the model falls into in the current run, empty if the model was removed.
+	CurrentCategory string `json:"current-category"`
+	// Added indicates the model is new in the current run.
+	Added bool `json:"added"`
+	// Removed indicates the model is no longer present in the current run.
+	Removed bool `json:"removed"`
+	// Regressed indicates the model moved to a category with a lower score.
+	Regressed bool `json:"regressed"`
+}
+
+// arrow returns a glyph representing the direction of the delta, for use in rendered reports.
+func (d ModelDelta) arrow() string {
+	switch {
+	case d.Added:
+		return "🆕"
+	case d.Removed:
+		return "❌"
+	case d.Delta > 0:
+		return "🔼"
+	case d.Delta < 0:
+		return "🔽"
+	default:
+		return "▪️"
+	}
+}
+
+// categoryRank returns the index of the given category within "metrics.AllAssessmentCategories", which is ordered from the lowest-scoring to the highest-scoring category. A lower rank means a lower-scoring category.
+func categoryRank(category *metrics.AssessmentCategory) int {
+	for rank, c := range metrics.AllAssessmentCategories {
+		if c == category {
+			return rank
+		}
+	}
+
+	return -1
+}
+
+// ModelDeltas computes the per-model deltas between the baseline and the current evaluation run, sorted by model name.
+func (d Diff) ModelDeltas() []ModelDelta {
+	models := make(map[string]struct{}, len(d.Baseline.AssessmentPerModel)+len(d.Current.AssessmentPerModel))
+	for model := range d.Baseline.AssessmentPerModel {
+		models[model] = struct{}{}
+	}
+	for model := range d.Current.AssessmentPerModel {
+		models[model] = struct{}{}
+	}
+
+	deltas := make([]ModelDelta, 0, len(models))
+	for model := range models {
+		delta := ModelDelta{
+			Model: model,
+		}
+
+		var baselineCategory, currentCategory *metrics.AssessmentCategory
+		if baseline, ok := d.Baseline.AssessmentPerModel[model]; ok {
+			delta.BaselineScore = baseline.Score()
+			baselineCategory = baseline.Category(d.Baseline.TotalScore)
+			delta.BaselineCategory = baselineCategory.Name
+		} else {
+			delta.Added = true
+		}
+
+		if current, ok := d.Current.AssessmentPerModel[model]; ok {
+			delta.CurrentScore = current.Score()
+			currentCategory = current.Category(d.Current.TotalScore)
+			delta.CurrentCategory = currentCategory.Name
+		} else {
+			delta.Removed = true
+		}
+
+		delta.Delta = int64(delta.CurrentScore) - int64(delta.BaselineScore)
+		// A model only counts as regressed if it actually dropped to a lower-scoring category; a raw score dip that
+		// stays within the same category is expected noise from nondeterministic LLM output, not a regression.
+		delta.Regressed = !delta.Added && !delta.Removed && baselineCategory != currentCategory && categoryRank(currentCategory) < categoryRank(baselineCategory)
+
+		deltas = append(deltas, delta)
+	}
+
+	sort.Slice(deltas, func(i, j int) bool {
+		return deltas[i].Model < deltas[j].Model
+	})
+
+	return deltas
+}
+
+// Summary holds a machine-readable summary of a Diff, suitable for CI systems to act on.
+type Summary struct {
+	// Regressions holds the number of models that moved to a lower-scoring category.
+	Regressions int `json:"regressions"`
+	// Improvements holds the number of models present in both runs that did not regress and whose total score increased.
+	Improvements int `json:"improvements"`
+	// Added holds the number of models present only in the current run.
+	Added int `json:"added"`
+	// Removed holds the number of models present only in the baseline run.
+	Removed int `json:"removed"`
+	// ModelDeltas holds the per-model deltas this summary was computed from.
+	ModelDeltas []ModelDelta `json:"model-deltas"`
+}
+
+// Summary computes a machine-readable summary of this Diff.
+func (d Diff) Summary() Summary {
+	return summarize(d.ModelDeltas())
+}
+
+// summarize aggregates the given model deltas into a Summary, split out from Diff.Summary so the aggregation itself can be tested without needing real metrics.Assessment data.
+func summarize(deltas []ModelDelta) Summary {
+	summary := Summary{
+		ModelDeltas: deltas,
+	}
+	for _, delta := range summary.ModelDeltas {
+		switch {
+		case delta.Added:
+			summary.Added++
+		case delta.Removed:
+			summary.Removed++
+		case delta.Regressed:
+			summary.Regressions++
+		case delta.Delta > 0:
+			summary.Improvements++
+		}
+	}
+
+	return summary
+}
+
+// ExitCode returns a process exit code suitable for a CI regression gate: 0 if no model regressed, 1 otherwise.
+func (s Summary) ExitCode() int {
+	if s.Regressions > 0 {
+		return 1
+	}
+
+	return 0
+}
+
+// WriteSummaryJSON writes this Diff's Summary as JSON to the given file, for CI systems that want to act on the result without parsing Markdown.
+func (d Diff) WriteSummaryJSON(path string) (err error) {
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return pkgerrors.WithStack(err)
+	}
+	defer func() {
+		if e := file.Close(); e != nil {
+			e = pkgerrors.WithStack(e)
+			if err == nil {
+				err = e
+			} else {
+				err = errors.Join(err, e)
+			}
+		}
+	}()
+
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "\t")
+	if err := encoder.Encode(d.Summary()); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	return nil
+}
+
+// diffTemplateContext holds the data exposed to the Diff Markdown template.
+type diffTemplateContext struct {
+	Diff
+
+	ModelDeltas []ModelDelta
+}
+
+// Arrow exposes ModelDelta.arrow to the template.
+func (diffTemplateContext) Arrow(delta ModelDelta) string {
+	return delta.arrow()
+}
+
+// diffTemplate holds the template for a Diff regression report.
+var diffTemplate = template.Must(template.New("template-report-diff").Parse(bytesutil.StringTrimIndentations(`
+	# Evaluation diff from {{.Current.DateTime.Format "2006-01-02 15:04:05"}}
+
+	Comparing ` + "`" + `{{.Current.Version}}` + "`" + ` (revision ` + "`" + `{{.Current.Revision}}` + "`" + `) against baseline ` + "`" + `{{.Baseline.Version}}` + "`" + ` (revision ` + "`" + `{{.Baseline.Revision}}` + "`" + `).
+
+	{{ if or .Baseline.AssessmentPerModel .Current.AssessmentPerModel }}![Bar chart comparing models per category between baseline and current run.]({{.SVGPath}})
+	{{ end }}
+	## Model changes
+
+	| | Model | Baseline category | Current category | Baseline score | Current score | Delta |
+	| --- | --- | --- | --- | --- | --- | --- |
+	{{ range $delta := .ModelDeltas -}}
+	| {{ $.Arrow $delta }} | ` + "`" + `{{ $delta.Model }}` + "`" + ` | {{ $delta.BaselineCategory }} | {{ $delta.CurrentCategory }} | {{ $delta.BaselineScore }} | {{ $delta.CurrentScore }} | {{ if ge $delta.Delta 0 }}+{{ end }}{{ $delta.Delta }} |
+	{{ end }}
+`)))
+
+// barChartDiffPerCategorySVG generates a bar chart comparing baseline and current model counts per category and writes it out as an SVG.
+func barChartDiffPerCategorySVG(writer io.Writer, categories []*metrics.AssessmentCategory, baselinePerCategory, currentPerCategory map[*metrics.AssessmentCategory][]string) (err error) {
+	bars := make([]chart.StackedBar, 0, len(categories))
+	for _, category := range categories {
+		baselineCount := len(baselinePerCategory[category])
+		currentCount := len(currentPerCategory[category])
+		if baselineCount == 0 && currentCount == 0 {
+			continue
+		}
+
+		bars = append(bars, chart.StackedBar{
+			Name: category.Name,
+			Values: []chart.Value{
+				{Label: "baseline", Value: float64(baselineCount)},
+				{Label: "current", Value: float64(currentCount)},
+			},
+		})
+	}
+
+	graph := chart.StackedBarChart{
+		Title: "Models per Category: baseline vs. current",
+		Bars:  bars,
+		Background: chart.Style{
+			Padding: chart.Box{
+				Top:    60,
+				Bottom: 40,
+			},
+		},
+		Height: 300,
+		Width:  (len(bars) + 2) * 80,
+	}
+
+	if err := graph.Render(chart.SVG, writer); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	return nil
+}
+
+// Render writes the rendered Diff Markdown report for this Diff to the writer.
+func (d Diff) Render(writer io.Writer) (err error) {
+	templateContext := diffTemplateContext{
+		Diff:        d,
+		ModelDeltas: d.ModelDeltas(),
+	}
+
+	if err := diffTemplate.Execute(writer, templateContext); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	return nil
+}
+
+// WriteToFile renders the Diff Markdown report and its comparison chart next to the given file.
+func (d Diff) WriteToFile(path string) (err error) {
+	directoryPath := filepath.Dir(path)
+	if err = os.MkdirAll(directoryPath, 0755); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	if len(d.Baseline.AssessmentPerModel) > 0 || len(d.Current.AssessmentPerModel) > 0 {
+		svgFile, err := os.Create(filepath.Join(directoryPath, d.SVGPath))
+		if err != nil {
+			return pkgerrors.WithStack(err)
+		}
+		defer func() {
+			if e := svgFile.Close(); e != nil {
+				e = pkgerrors.WithStack(e)
+				if err == nil {
+					err = e
+				} else {
+					err = errors.Join(err, e)
+				}
+			}
+		}()
+
+		if err := barChartDiffPerCategorySVG(svgFile, metrics.AllAssessmentCategories, d.Baseline.modelsPerCategory(), d.Current.modelsPerCategory()); err != nil {
+			return pkgerrors.WithStack(err)
+		}
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return pkgerrors.WithStack(err)
+	}
+	defer func() {
+		if e := file.Close(); e != nil {
+			e = pkgerrors.WithStack(e)
+			if err == nil {
+				err = e
+			} else {
+				err = errors.Join(err, e)
+			}
+		}
+	}()
+
+	if err := d.Render(file); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	return nil
+}
+
+// LoadAssessmentFromCSV hydrates an AssessmentPerModel from a CSV file previously written by an evaluation run, so it can be used as the baseline of a Diff. The CSV is expected to hold one row per model and assessment key, with the columns "model", "key" and "score".
+func LoadAssessmentFromCSV(path string) (assessmentPerModel AssessmentPerModel, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+	defer func() {
+		if e := file.Close(); e != nil {
+			e = pkgerrors.WithStack(e)
+			if err == nil {
+				err = e
+			} else {
+				err = errors.Join(err, e)
+			}
+		}
+	}()
+
+	reader := csv.NewReader(file)
+	header, err := reader.Read()
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[name] = i
+	}
+	for _, name := range []string{"model", "key", "score"} {
+		if _, ok := columns[name]; !ok {
+			return nil, pkgerrors.Errorf("CSV file %q is missing required column %q", path, name)
+		}
+	}
+
+	assessmentPerModel = make(AssessmentPerModel)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, pkgerrors.WithStack(err)
+		}
+
+		model := record[columns["model"]]
+		key := record[columns["key"]]
+		score, err := strconv.ParseUint(record[columns["score"]], 10, 64)
+		if err != nil {
+			return nil, pkgerrors.WithStack(err)
+		}
+
+		if _, ok := assessmentPerModel[model]; !ok {
+			assessmentPerModel[model] = metrics.Assessment{}
+		}
+		assessmentPerModel[model][metrics.AssessmentKey(key)] = score
+	}
+
+	return assessmentPerModel, nil
+}
@@ -0,0 +1,119 @@
+package report
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/symflower/eval-dev-quality/evaluate/metrics"
+)
+
+func TestCategoryRank(t *testing.T) {
+	low := &metrics.AssessmentCategory{Name: "low", Description: "low category"}
+	mid := &metrics.AssessmentCategory{Name: "mid", Description: "mid category"}
+	high := &metrics.AssessmentCategory{Name: "high", Description: "high category"}
+
+	originalCategories := metrics.AllAssessmentCategories
+	metrics.AllAssessmentCategories = []*metrics.AssessmentCategory{low, mid, high}
+	defer func() {
+		metrics.AllAssessmentCategories = originalCategories
+	}()
+
+	assert.Equal(t, 0, categoryRank(low))
+	assert.Equal(t, 1, categoryRank(mid))
+	assert.Equal(t, 2, categoryRank(high))
+	assert.Equal(t, -1, categoryRank(&metrics.AssessmentCategory{Name: "unknown"}))
+}
+
+func TestSummarize(t *testing.T) {
+	tests := []struct {
+		name     string
+		deltas   []ModelDelta
+		expected Summary
+	}{
+		{
+			name: "added and removed models are not counted as regressions or improvements",
+			deltas: []ModelDelta{
+				{Model: "new-model", Added: true},
+				{Model: "old-model", Removed: true},
+			},
+			expected: Summary{Added: 1, Removed: 1},
+		},
+		{
+			name: "a score drop within the same category is not a regression",
+			deltas: []ModelDelta{
+				{Model: "noisy-model", BaselineScore: 10, CurrentScore: 8, Delta: -2, Regressed: false},
+			},
+			expected: Summary{},
+		},
+		{
+			name: "a category drop is a regression",
+			deltas: []ModelDelta{
+				{Model: "regressed-model", BaselineScore: 10, CurrentScore: 1, Delta: -9, Regressed: true},
+			},
+			expected: Summary{Regressions: 1},
+		},
+		{
+			name: "a positive delta without a category change is an improvement",
+			deltas: []ModelDelta{
+				{Model: "improved-model", BaselineScore: 1, CurrentScore: 10, Delta: 9},
+			},
+			expected: Summary{Improvements: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summary := summarize(tt.deltas)
+
+			assert.Equal(t, tt.expected.Added, summary.Added)
+			assert.Equal(t, tt.expected.Removed, summary.Removed)
+			assert.Equal(t, tt.expected.Regressions, summary.Regressions)
+			assert.Equal(t, tt.expected.Improvements, summary.Improvements)
+			assert.Equal(t, tt.deltas, summary.ModelDeltas)
+		})
+	}
+}
+
+func TestSummaryExitCode(t *testing.T) {
+	assert.Equal(t, 0, Summary{}.ExitCode())
+	assert.Equal(t, 0, Summary{Improvements: 3, Added: 1}.ExitCode())
+	assert.Equal(t, 1, Summary{Regressions: 1}.ExitCode())
+}
+
+func TestModelDeltaArrow(t *testing.T) {
+	assert.Equal(t, "🆕", ModelDelta{Added: true}.arrow())
+	assert.Equal(t, "❌", ModelDelta{Removed: true}.arrow())
+	assert.Equal(t, "🔼", ModelDelta{Delta: 1}.arrow())
+	assert.Equal(t, "🔽", ModelDelta{Delta: -1}.arrow())
+	assert.Equal(t, "▪️", ModelDelta{Delta: 0}.arrow())
+}
+
+func TestLoadAssessmentFromCSV(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "assessments.csv")
+	require.NoError(t, os.WriteFile(path, []byte("model,key,score\n"+
+		"model-a,compilation,5\n"+
+		"model-a,tests,3\n"+
+		"model-b,compilation,1\n"), 0644))
+
+	assessmentPerModel, err := LoadAssessmentFromCSV(path)
+	require.NoError(t, err)
+
+	require.Contains(t, assessmentPerModel, "model-a")
+	assert.Equal(t, uint64(5), assessmentPerModel["model-a"][metrics.AssessmentKey("compilation")])
+	assert.Equal(t, uint64(3), assessmentPerModel["model-a"][metrics.AssessmentKey("tests")])
+
+	require.Contains(t, assessmentPerModel, "model-b")
+	assert.Equal(t, uint64(1), assessmentPerModel["model-b"][metrics.AssessmentKey("compilation")])
+}
+
+func TestLoadAssessmentFromCSVMissingColumn(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "assessments.csv")
+	require.NoError(t, os.WriteFile(path, []byte("model,score\nmodel-a,5\n"), 0644))
+
+	_, err := LoadAssessmentFromCSV(path)
+	assert.Error(t, err)
+}
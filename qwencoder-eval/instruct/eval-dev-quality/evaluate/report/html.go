@@ -0,0 +1,125 @@
+package report
+
+import (
+	"errors"
+	"html/template"
+	"io"
+	"os"
+	"path/filepath"
+
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/symflower/eval-dev-quality/evaluate/metrics"
+)
+
+// HTMLRenderer renders an evaluation Context as a standalone HTML page.
+type HTMLRenderer struct {
+	Context
+}
+
+// htmlTemplateContext holds the data exposed to the HTML report template.
+type htmlTemplateContext struct {
+	Context
+
+	Categories        []*metrics.AssessmentCategory
+	ModelsPerCategory map[*metrics.AssessmentCategory][]string
+}
+
+// ModelLogName formats a model name to match the logging structure.
+func (c htmlTemplateContext) ModelLogName(modelName string) string {
+	return MarkdownTemplateContext(c).ModelLogName(modelName)
+}
+
+// htmlTemplate holds the template for an HTML report.
+var htmlTemplate = template.Must(template.New("template-report-html").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+	<meta charset="utf-8">
+	<title>Evaluation from {{.DateTime.Format "2006-01-02 15:04:05"}}</title>
+</head>
+<body>
+	<h1>Evaluation from {{.DateTime.Format "2006-01-02 15:04:05"}}</h1>
+	<img src="{{.SVGPath}}" alt="Bar chart that categorizes all evaluated models.">
+	{{ if .PerModelChartPath }}<img src="{{.PerModelChartPath}}" alt="Bar chart of the total score per model.">
+	{{ end }}
+	{{ if .StackedChartPath }}<img src="{{.StackedChartPath}}" alt="Stacked bar chart of the per-model score breakdown.">
+	{{ end }}
+	{{ if .HeatmapChartPath }}<img src="{{.HeatmapChartPath}}" alt="Heatmap of models and assessment keys, colored by score.">
+	{{ end }}
+	<p>This report was generated by <a href="https://github.com/symflower/eval-dev-quality">DevQualityEval benchmark</a> in version <code>{{.Version}}</code> - revision <code>{{.Revision}}</code>.</p>
+	<h2>Results</h2>
+	<p><em>Keep in mind that LLMs are nondeterministic. The following results just reflect a current snapshot.</em></p>
+	<p>The results of all models have been divided into the following categories:</p>
+	<ul>
+	{{ range $category := .Categories -}}
+		<li>{{ $category.Name }}: {{ $category.Description }}</li>
+	{{ end }}
+	</ul>
+	<p>Detailed scoring can be found <a href="{{.CSVPath}}">here</a>. The complete log of the evaluation with all outputs can be found here:</p>
+	<ul>
+	{{ range .LogPaths -}}
+		<li>{{.}}</li>
+	{{ end }}
+	</ul>
+	{{ range $category := .Categories -}}
+	{{ with $modelNames := index $.ModelsPerCategory $category -}}
+	<h3>Result category &quot;{{ $category.Name }}&quot;</h3>
+	<p>{{ $category.Description }}</p>
+	<ul>
+	{{ range $modelName := $modelNames -}}
+		<li><a href="{{ $.ModelLogName $modelName }}"><code>{{ $modelName }}</code></a></li>
+	{{ end }}
+	</ul>
+	{{ end }}
+	{{- end }}
+</body>
+</html>
+`))
+
+// Render writes the rendered HTML report for the given context to the writer.
+func (h *HTMLRenderer) Render(writer io.Writer, context Context) (err error) {
+	templateContext := htmlTemplateContext{
+		Context:    context,
+		Categories: metrics.AllAssessmentCategories,
+	}
+	templateContext.ModelsPerCategory = context.modelsPerCategory()
+
+	if err := htmlTemplate.Execute(writer, templateContext); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	return nil
+}
+
+// WriteToFile renders the HTML to the given file.
+func (h *HTMLRenderer) WriteToFile(path string) (err error) {
+	directoryPath := filepath.Dir(path)
+	if err = os.MkdirAll(directoryPath, 0755); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	if err := writeCategoryChart(h.Context, directoryPath); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return pkgerrors.WithStack(err)
+	}
+	defer func() {
+		if e := file.Close(); e != nil {
+			e = pkgerrors.WithStack(e)
+			if err == nil {
+				err = e
+			} else {
+				err = errors.Join(err, e)
+			}
+		}
+	}()
+
+	if err := h.Render(file, h.Context); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	return nil
+}
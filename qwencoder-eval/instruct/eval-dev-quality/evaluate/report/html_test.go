@@ -0,0 +1,68 @@
+package report
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/symflower/eval-dev-quality/evaluate/metrics"
+)
+
+func TestHTMLRendererRender(t *testing.T) {
+	category := &metrics.AssessmentCategory{Name: "gold", Description: "gold category"}
+	originalCategories := metrics.AllAssessmentCategories
+	metrics.AllAssessmentCategories = []*metrics.AssessmentCategory{category}
+	defer func() {
+		metrics.AllAssessmentCategories = originalCategories
+	}()
+
+	context := Context{
+		Version:           "1.2.3",
+		Revision:          "abc",
+		SVGPath:           "chart.svg",
+		PerModelChartPath: "per-model.svg",
+		StackedChartPath:  "stacked.svg",
+		HeatmapChartPath:  "heatmap.svg",
+		AssessmentPerModel: AssessmentPerModel{
+			"model-a": metrics.Assessment{metrics.AssessmentKey("compilation"): 5},
+		},
+		TotalScore: 10,
+	}
+
+	renderer := &HTMLRenderer{}
+
+	var buffer bytes.Buffer
+	require.NoError(t, renderer.Render(&buffer, context))
+
+	rendered := buffer.String()
+	assert.Contains(t, rendered, "<!DOCTYPE html>")
+	assert.Contains(t, rendered, `<img src="chart.svg"`)
+	assert.Contains(t, rendered, `<img src="per-model.svg"`)
+	assert.Contains(t, rendered, `<img src="stacked.svg"`)
+	assert.Contains(t, rendered, `<img src="heatmap.svg"`)
+	assert.Contains(t, rendered, "<code>1.2.3</code>")
+	assert.Contains(t, rendered, "model-a")
+	assert.Contains(t, rendered, `Result category &quot;gold&quot;`)
+}
+
+func TestHTMLRendererRenderOmitsOptionalChartsWhenPathsEmpty(t *testing.T) {
+	category := &metrics.AssessmentCategory{Name: "gold", Description: "gold category"}
+	originalCategories := metrics.AllAssessmentCategories
+	metrics.AllAssessmentCategories = []*metrics.AssessmentCategory{category}
+	defer func() {
+		metrics.AllAssessmentCategories = originalCategories
+	}()
+
+	renderer := &HTMLRenderer{}
+
+	var buffer bytes.Buffer
+	require.NoError(t, renderer.Render(&buffer, Context{SVGPath: "chart.svg"}))
+
+	rendered := buffer.String()
+	assert.Contains(t, rendered, `<img src="chart.svg"`)
+	assert.NotContains(t, rendered, `alt="Bar chart of the total score per model."`)
+	assert.NotContains(t, rendered, `alt="Stacked bar chart of the per-model score breakdown."`)
+	assert.NotContains(t, rendered, `alt="Heatmap of models and assessment keys, colored by score."`)
+}
@@ -0,0 +1,150 @@
+package report
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/symflower/eval-dev-quality/evaluate/metrics"
+)
+
+// JSONRenderer renders an evaluation Context as machine-consumable JSON.
+type JSONRenderer struct {
+	Context
+}
+
+// jsonModel holds the JSON representation of a single model's assessment within a category.
+type jsonModel struct {
+	// Name holds the name of the model.
+	Name string `json:"name"`
+	// Score holds the model's total score.
+	Score uint64 `json:"score"`
+	// Percent holds the model's score as a percentage of the total reachable score.
+	Percent float64 `json:"percent"`
+	// Scores holds the model's score broken down per metrics.AssessmentKey.
+	Scores map[string]uint64 `json:"scores"`
+}
+
+// jsonCategory holds the JSON representation of an assessment category and the models that fall into it.
+type jsonCategory struct {
+	// Name holds the name of the category.
+	Name string `json:"name"`
+	// Description holds the description of the category.
+	Description string `json:"description"`
+	// Models holds the models that fall into this category, together with their scores.
+	Models []jsonModel `json:"models"`
+}
+
+// jsonDocument holds the full JSON representation of an evaluation report.
+type jsonDocument struct {
+	// DateTime holds the timestamp of the evaluation, in RFC 3339 format.
+	DateTime string `json:"date-time"`
+	// Version holds the version of the evaluation tool.
+	Version string `json:"version"`
+	// Revision holds the Git revision of the evaluation tool.
+	Revision string `json:"revision"`
+
+	// CSVPath holds the path of detailed CSV results.
+	CSVPath string `json:"csv-path"`
+	// LogPaths holds the path of detailed logs.
+	LogPaths []string `json:"log-paths"`
+	// ChartPath holds the path of the categorized bar chart SVG.
+	ChartPath string `json:"chart-path"`
+
+	// TotalScore holds the total reachable score per task.
+	TotalScore uint64 `json:"total-score"`
+	// Categories holds all categories together with the models that fall into them.
+	Categories []jsonCategory `json:"categories"`
+}
+
+// Render writes the rendered JSON report for the given context to the writer.
+func (j *JSONRenderer) Render(writer io.Writer, context Context) (err error) {
+	modelsPerCategory := context.modelsPerCategory()
+
+	document := jsonDocument{
+		DateTime:   context.DateTime.Format("2006-01-02T15:04:05Z07:00"),
+		Version:    context.Version,
+		Revision:   context.Revision,
+		CSVPath:    context.CSVPath,
+		LogPaths:   context.LogPaths,
+		ChartPath:  context.SVGPath,
+		TotalScore: context.TotalScore,
+		Categories: make([]jsonCategory, 0, len(metrics.AllAssessmentCategories)),
+	}
+	for _, category := range metrics.AllAssessmentCategories {
+		modelNames := modelsPerCategory[category]
+		models := make([]jsonModel, 0, len(modelNames))
+		for _, modelName := range modelNames {
+			assessment := context.AssessmentPerModel[modelName]
+			score := assessment.Score()
+
+			var percent float64
+			if context.TotalScore > 0 {
+				percent = float64(score) / float64(context.TotalScore) * 100
+			}
+
+			scores := make(map[string]uint64, len(assessment))
+			for key, value := range assessment {
+				scores[string(key)] = value
+			}
+
+			models = append(models, jsonModel{
+				Name:    modelName,
+				Score:   score,
+				Percent: percent,
+				Scores:  scores,
+			})
+		}
+
+		document.Categories = append(document.Categories, jsonCategory{
+			Name:        category.Name,
+			Description: category.Description,
+			Models:      models,
+		})
+	}
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "\t")
+	if err := encoder.Encode(document); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	return nil
+}
+
+// WriteToFile renders the JSON to the given file.
+func (j *JSONRenderer) WriteToFile(path string) (err error) {
+	directoryPath := filepath.Dir(path)
+	if err = os.MkdirAll(directoryPath, 0755); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	if err := writeCategoryChart(j.Context, directoryPath); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return pkgerrors.WithStack(err)
+	}
+	defer func() {
+		if e := file.Close(); e != nil {
+			e = pkgerrors.WithStack(e)
+			if err == nil {
+				err = e
+			} else {
+				err = errors.Join(err, e)
+			}
+		}
+	}()
+
+	if err := j.Render(file, j.Context); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/symflower/eval-dev-quality/evaluate/metrics"
+)
+
+func TestJSONRendererRender(t *testing.T) {
+	category := &metrics.AssessmentCategory{Name: "gold", Description: "gold category"}
+	originalCategories := metrics.AllAssessmentCategories
+	metrics.AllAssessmentCategories = []*metrics.AssessmentCategory{category}
+	defer func() {
+		metrics.AllAssessmentCategories = originalCategories
+	}()
+
+	context := Context{
+		TotalScore: 10,
+		AssessmentPerModel: AssessmentPerModel{
+			"model-a": metrics.Assessment{metrics.AssessmentKey("compilation"): 5},
+		},
+	}
+
+	renderer := &JSONRenderer{}
+
+	var buffer bytes.Buffer
+	require.NoError(t, renderer.Render(&buffer, context))
+
+	var document jsonDocument
+	require.NoError(t, json.Unmarshal(buffer.Bytes(), &document))
+
+	require.Len(t, document.Categories, 1)
+	require.Len(t, document.Categories[0].Models, 1)
+
+	model := document.Categories[0].Models[0]
+	assert.Equal(t, "model-a", model.Name)
+	assert.Equal(t, uint64(5), model.Score)
+	assert.Equal(t, 50.0, model.Percent)
+	assert.Equal(t, map[string]uint64{"compilation": 5}, model.Scores)
+}
+
+func TestJSONRendererRenderEmptyContext(t *testing.T) {
+	category := &metrics.AssessmentCategory{Name: "gold", Description: "gold category"}
+	originalCategories := metrics.AllAssessmentCategories
+	metrics.AllAssessmentCategories = []*metrics.AssessmentCategory{category}
+	defer func() {
+		metrics.AllAssessmentCategories = originalCategories
+	}()
+
+	renderer := &JSONRenderer{}
+
+	var buffer bytes.Buffer
+	require.NoError(t, renderer.Render(&buffer, Context{}))
+
+	var document jsonDocument
+	require.NoError(t, json.Unmarshal(buffer.Bytes(), &document))
+	require.Len(t, document.Categories, 1)
+	assert.Empty(t, document.Categories[0].Models)
+}
@@ -0,0 +1,220 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/symflower/eval-dev-quality/evaluate/metrics"
+)
+
+// LiveWriter incrementally renders a Markdown report as assessments come in, so long-running evaluation benchmarks can be watched while they are still in progress.
+type LiveWriter struct {
+	// path holds the file path the report is atomically rewritten to on every update.
+	path string
+
+	mutex        sync.Mutex
+	context      Context
+	renderer     MarkdownRenderer
+	htmlRenderer HTMLRenderer
+	rendered     []byte
+	renderedHTML []byte
+	// charts holds the most recently rendered bytes of every chart file, keyed by the path they were written to, so Serve can hand them out without re-rendering or touching disk.
+	charts map[string][]byte
+}
+
+// NewLiveWriter creates a LiveWriter that atomically rewrites the Markdown report at the given path whenever a new assessment is added. The given context is used as the initial state, and is expected to have an empty "AssessmentPerModel".
+func NewLiveWriter(path string, initial Context) (writer *LiveWriter) {
+	if initial.AssessmentPerModel == nil {
+		initial.AssessmentPerModel = make(AssessmentPerModel)
+	}
+
+	return &LiveWriter{
+		path:    path,
+		context: initial,
+		charts:  make(map[string][]byte),
+	}
+}
+
+// AddAssessment adds or overwrites a model's assessment and atomically rewrites the Markdown report and its charts.
+func (w *LiveWriter) AddAssessment(model string, assessment metrics.Assessment) (err error) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.context.AssessmentPerModel[model] = assessment
+
+	return w.writeLocked()
+}
+
+// writeFileAtomically writes the given content to the given path by writing it to a temporary file in the same directory first and then renaming it into place, so concurrent readers never observe a partially written file. Callers must ensure "directoryPath" exists.
+func writeFileAtomically(directoryPath string, path string, content []byte) (err error) {
+	temporaryFile, err := os.CreateTemp(directoryPath, filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return pkgerrors.WithStack(err)
+	}
+	temporaryPath := temporaryFile.Name()
+	defer func() {
+		// Best-effort cleanup; once renamed below, the temporary file no longer exists under this name.
+		_ = os.Remove(temporaryPath)
+	}()
+
+	if _, err := temporaryFile.Write(content); err != nil {
+		_ = temporaryFile.Close()
+
+		return pkgerrors.WithStack(err)
+	}
+	if err := temporaryFile.Close(); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	if err := os.Rename(temporaryPath, path); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	return nil
+}
+
+// writeChartsLocked renders the categorized bar chart and the per-model/stacked/heatmap charts and atomically rewrites them on disk, skipping every chart whose path is empty and keeping a copy of their bytes in "w.charts" for Serve. Callers must hold "w.mutex".
+func (w *LiveWriter) writeChartsLocked(directoryPath string) (err error) {
+	if len(w.context.AssessmentPerModel) == 0 {
+		return nil
+	}
+
+	charts := Charts{Context: w.context}
+
+	named := []struct {
+		path   string
+		render func(buffer *bytes.Buffer) error
+	}{
+		{
+			path: w.context.SVGPath,
+			render: func(buffer *bytes.Buffer) error {
+				return barChartModelsPerCategoriesSVG(buffer, metrics.AllAssessmentCategories, w.context.modelsPerCategory())
+			},
+		},
+		{
+			path: w.context.PerModelChartPath,
+			render: func(buffer *bytes.Buffer) error {
+				_, err := charts.PerModelScore().WriteTo(buffer)
+
+				return err
+			},
+		},
+		{
+			path: w.context.StackedChartPath,
+			render: func(buffer *bytes.Buffer) error {
+				_, err := charts.StackedCategoryBreakdown().WriteTo(buffer)
+
+				return err
+			},
+		},
+		{
+			path: w.context.HeatmapChartPath,
+			render: func(buffer *bytes.Buffer) error {
+				_, err := charts.Heatmap().WriteTo(buffer)
+
+				return err
+			},
+		},
+	}
+
+	for _, chart := range named {
+		if chart.path == "" {
+			continue
+		}
+
+		var buffer bytes.Buffer
+		if err := chart.render(&buffer); err != nil {
+			return pkgerrors.WithStack(err)
+		}
+
+		fullPath := filepath.Join(directoryPath, chart.path)
+		if err := writeFileAtomically(directoryPath, fullPath, buffer.Bytes()); err != nil {
+			return pkgerrors.WithStack(err)
+		}
+
+		w.charts[chart.path] = buffer.Bytes()
+	}
+
+	return nil
+}
+
+// writeLocked renders the current state and atomically rewrites the report file and its charts. Callers must hold "w.mutex".
+func (w *LiveWriter) writeLocked() (err error) {
+	directoryPath := filepath.Dir(w.path)
+	if err := os.MkdirAll(directoryPath, 0755); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	if err := w.writeChartsLocked(directoryPath); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	w.renderer.Context = w.context
+	var buffer bytes.Buffer
+	if err := w.renderer.Render(&buffer, w.context); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	if err := writeFileAtomically(directoryPath, w.path, buffer.Bytes()); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+	w.rendered = buffer.Bytes()
+
+	w.htmlRenderer.Context = w.context
+	var htmlBuffer bytes.Buffer
+	if err := w.htmlRenderer.Render(&htmlBuffer, w.context); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+	w.renderedHTML = htmlBuffer.Bytes()
+
+	return nil
+}
+
+// Serve starts a small embedded HTTP server on the given address that serves the most recently rendered report as HTML, along with its chart assets at their own paths, so the report can be refreshed in a browser while the evaluation is still running. Serve blocks until the given context is cancelled or the server fails.
+func (w *LiveWriter) Serve(ctx context.Context, addr string) (err error) {
+	server := &http.Server{
+		Addr: addr,
+		Handler: http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+			w.mutex.Lock()
+			renderedHTML := w.renderedHTML
+			chart, isChart := w.charts[chartPathFromRequest(request.URL.Path)]
+			w.mutex.Unlock()
+
+			if isChart {
+				response.Header().Set("Content-Type", "image/svg+xml")
+				_, _ = response.Write(chart)
+
+				return
+			}
+
+			response.Header().Set("Content-Type", "text/html; charset=utf-8")
+			_, _ = response.Write(renderedHTML)
+		}),
+	}
+
+	go func() {
+		<-ctx.Done()
+		_ = server.Close()
+	}()
+
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return pkgerrors.WithStack(err)
+	}
+
+	return nil
+}
+
+// chartPathFromRequest strips the leading "/" from a request path, turning it into the relative path "w.charts" is keyed by.
+func chartPathFromRequest(urlPath string) string {
+	if len(urlPath) > 0 && urlPath[0] == '/' {
+		return urlPath[1:]
+	}
+
+	return urlPath
+}
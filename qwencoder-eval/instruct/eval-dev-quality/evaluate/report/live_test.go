@@ -0,0 +1,114 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/symflower/eval-dev-quality/evaluate/metrics"
+)
+
+func TestLiveWriterAddAssessmentWritesFileAtomically(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.md")
+	writer := NewLiveWriter(path, Context{Version: "1.0.0"})
+
+	require.NoError(t, writer.AddAssessment("model-a", metrics.Assessment{metrics.AssessmentKey("compilation"): 5}))
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "model-a")
+	assert.Equal(t, content, writer.rendered)
+
+	// No leftover temporary files should remain in the directory after the rename.
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	for _, entry := range entries {
+		assert.NotContains(t, entry.Name(), ".tmp")
+	}
+}
+
+func TestLiveWriterAddAssessmentWritesChartsAtomically(t *testing.T) {
+	directory := t.TempDir()
+	path := filepath.Join(directory, "report.md")
+	writer := NewLiveWriter(path, Context{SVGPath: "chart.svg"})
+
+	require.NoError(t, writer.AddAssessment("model-a", metrics.Assessment{metrics.AssessmentKey("compilation"): 5}))
+
+	content, err := os.ReadFile(filepath.Join(directory, "chart.svg"))
+	require.NoError(t, err)
+	assert.NotEmpty(t, content)
+	assert.Equal(t, content, writer.charts["chart.svg"])
+
+	// No leftover temporary files should remain in the directory after the rename.
+	entries, err := os.ReadDir(directory)
+	require.NoError(t, err)
+	for _, entry := range entries {
+		assert.NotContains(t, entry.Name(), ".tmp")
+	}
+}
+
+func TestLiveWriterAddAssessmentOverwritesExistingModel(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.md")
+	writer := NewLiveWriter(path, Context{})
+
+	require.NoError(t, writer.AddAssessment("model-a", metrics.Assessment{metrics.AssessmentKey("compilation"): 5}))
+	require.NoError(t, writer.AddAssessment("model-a", metrics.Assessment{metrics.AssessmentKey("compilation"): 9}))
+
+	assert.Len(t, writer.context.AssessmentPerModel, 1)
+	assert.Equal(t, uint64(9), writer.context.AssessmentPerModel["model-a"][metrics.AssessmentKey("compilation")])
+}
+
+func TestLiveWriterServe(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.md")
+	writer := NewLiveWriter(path, Context{SVGPath: "chart.svg"})
+	require.NoError(t, writer.AddAssessment("model-a", metrics.Assessment{metrics.AssessmentKey("compilation"): 5}))
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- writer.Serve(ctx, addr)
+	}()
+
+	var response *http.Response
+	for i := 0; i < 50; i++ {
+		response, err = http.Get(fmt.Sprintf("http://%s/", addr))
+		if err == nil {
+			break
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	require.NoError(t, err)
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), "model-a")
+	assert.Contains(t, string(body), `<img src="chart.svg"`, "the served report must be HTML, not raw Markdown")
+	assert.Equal(t, "text/html; charset=utf-8", response.Header.Get("Content-Type"))
+
+	chartResponse, err := http.Get(fmt.Sprintf("http://%s/chart.svg", addr))
+	require.NoError(t, err)
+	defer chartResponse.Body.Close()
+
+	chartBody, err := io.ReadAll(chartResponse.Body)
+	require.NoError(t, err)
+	assert.NotEmpty(t, chartBody, "the chart referenced by the HTML report must be reachable over the server")
+	assert.Equal(t, "image/svg+xml", chartResponse.Header.Get("Content-Type"))
+
+	cancel()
+	require.NoError(t, <-serveErr)
+}
@@ -5,10 +5,10 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"text/template"
-	"time"
 
 	pkgerrors "github.com/pkg/errors"
 	"github.com/wcharczuk/go-chart/v2"
@@ -19,40 +19,28 @@ import (
 	"github.com/symflower/eval-dev-quality/log"
 )
 
-// Markdown holds the values for exporting a Markdown report.
-type Markdown struct {
-	// DateTime holds the timestamp of the evaluation.
-	DateTime time.Time
-	// Version holds the version of the evaluation tool.
-	Version string
-	// Revision holds the Git revision of the evaluation tool.
-	Revision string
-
-	// CSVPath holds the path of detailed CSV results.
-	CSVPath string
-	// LogPaths holds the path of detailed logs.
-	LogPaths []string
-	// ModelLogsPath holds the path of the model logs.
-	ModelLogsPath string
-	// SVGPath holds the path of the charted results.
-	SVGPath string
-
-	// AssessmentPerModel holds a collection of assessments per model.
-	AssessmentPerModel AssessmentPerModel
-	// TotalScore holds the total reachable score per task.
-	TotalScore uint64
+// MarkdownRenderer renders an evaluation Context as Markdown.
+type MarkdownRenderer struct {
+	Context
+
+	// TemplatePath optionally holds the path of a user-supplied "text/template" file overriding the embedded default Markdown template. When empty, the embedded default template is used.
+	//
+	// NOTE: this snapshot of the repository does not contain an evaluate/cmd package, so the "--report-template"
+	// flag is not registered anywhere yet. Wiring it up means: register a string flag and assign its value to
+	// MarkdownRenderer.TemplatePath before calling WriteToFile.
+	TemplatePath string
 }
 
-// markdownTemplateContext holds the template for a Markdown report.
-type markdownTemplateContext struct {
-	Markdown
+// MarkdownTemplateContext holds the data exposed to a Markdown report template, be it the embedded default or a user-supplied one via "MarkdownRenderer.TemplatePath".
+type MarkdownTemplateContext struct {
+	Context
 
 	Categories        []*metrics.AssessmentCategory
 	ModelsPerCategory map[*metrics.AssessmentCategory][]string
 }
 
 // ModelLogName formats a model name to match the logging structure.
-func (c markdownTemplateContext) ModelLogName(modelName string) string {
+func (c MarkdownTemplateContext) ModelLogName(modelName string) string {
 	modelPath := filepath.Join(c.ModelLogsPath, log.CleanModelNameForFileSystem(modelName)) + string(os.PathSeparator)
 	if !filepath.IsAbs(modelPath) {
 		// Ensure we reference the models relative to the Markdown file itself.
@@ -67,12 +55,38 @@ func (c markdownTemplateContext) ModelLogName(modelName string) string {
 	return modelPath
 }
 
+// MarkdownTemplateFuncs holds the functions available to a Markdown report template, be it the embedded default or a user-supplied one via "MarkdownRenderer.TemplatePath".
+var MarkdownTemplateFuncs = template.FuncMap{
+	// percent formats a score out of a total as a "12.3%" style percentage.
+	"percent": func(score, total uint64) string {
+		if total == 0 {
+			return "0.0%"
+		}
+
+		return strconv.FormatFloat(float64(score)/float64(total)*100, 'f', 1, 64) + "%"
+	},
+	// sortedModels returns the given models sorted alphabetically, so custom templates can present a stable order.
+	"sortedModels": func(models []string) []string {
+		sorted := make([]string, len(models))
+		copy(sorted, models)
+		sort.Strings(sorted)
+
+		return sorted
+	},
+}
+
 // markdownTemplate holds the template for a Markdown report.
-var markdownTemplate = template.Must(template.New("template-report").Parse(bytesutil.StringTrimIndentations(`
+var markdownTemplate = template.Must(template.New("template-report").Funcs(MarkdownTemplateFuncs).Parse(bytesutil.StringTrimIndentations(`
 	# Evaluation from {{.DateTime.Format "2006-01-02 15:04:05"}}
 
 	![Bar chart that categorizes all evaluated models.]({{.SVGPath}})
 
+	{{ if .PerModelChartPath }}![Bar chart of the total score per model.]({{.PerModelChartPath}})
+	{{ end }}
+	{{ if .StackedChartPath }}![Stacked bar chart of the per-model score breakdown.]({{.StackedChartPath}})
+	{{ end }}
+	{{ if .HeatmapChartPath }}![Heatmap of models and assessment keys, colored by score.]({{.HeatmapChartPath}})
+	{{ end }}
 	This report was generated by [DevQualityEval benchmark](https://github.com/symflower/eval-dev-quality) in ` + "`" + `version {{.Version}}` + "`" + ` - ` + "`" + `revision {{.Revision}}` + "`" + `.
 
 	## Results
@@ -149,40 +163,23 @@ func barChartModelsPerCategoriesSVG(writer io.Writer, categories []*metrics.Asse
 	return nil
 }
 
-// format formats the markdown values in the template to the given writer.
-func (m Markdown) format(writer io.Writer, markdownFileDirectoryPath string) (err error) {
-	templateContext := markdownTemplateContext{
-		Markdown:   m,
+// Render writes the rendered Markdown report for the given context to the writer.
+func (m *MarkdownRenderer) Render(writer io.Writer, context Context) (err error) {
+	templateContext := MarkdownTemplateContext{
+		Context:    context,
 		Categories: metrics.AllAssessmentCategories,
 	}
-	templateContext.ModelsPerCategory = make(map[*metrics.AssessmentCategory][]string, len(metrics.AllAssessmentCategories))
-	for model, assessment := range m.AssessmentPerModel {
-		category := assessment.Category(m.TotalScore)
-		templateContext.ModelsPerCategory[category] = append(templateContext.ModelsPerCategory[category], model)
-	}
+	templateContext.ModelsPerCategory = context.modelsPerCategory()
 
-	svgFile, err := os.Create(filepath.Join(markdownFileDirectoryPath, m.SVGPath))
-	if err != nil {
-		return pkgerrors.WithStack(err)
-	}
-	defer func() {
-		if e := svgFile.Close(); e != nil {
-			e = pkgerrors.WithStack(e)
-			if err == nil {
-				err = e
-			} else {
-				err = errors.Join(err, e)
-			}
-		}
-	}()
-
-	if len(templateContext.AssessmentPerModel) > 0 {
-		if err := barChartModelsPerCategoriesSVG(svgFile, metrics.AllAssessmentCategories, templateContext.ModelsPerCategory); err != nil {
+	t := markdownTemplate
+	if m.TemplatePath != "" {
+		t, err = template.New(filepath.Base(m.TemplatePath)).Funcs(MarkdownTemplateFuncs).ParseFiles(m.TemplatePath)
+		if err != nil {
 			return pkgerrors.WithStack(err)
 		}
 	}
 
-	if err := markdownTemplate.Execute(writer, templateContext); err != nil {
+	if err := t.Execute(writer, templateContext); err != nil {
 		return pkgerrors.WithStack(err)
 	}
 
@@ -190,10 +187,16 @@ func (m Markdown) format(writer io.Writer, markdownFileDirectoryPath string) (er
 }
 
 // WriteToFile renders the Markdown to the given file.
-func (m Markdown) WriteToFile(path string) (err error) {
-	if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+func (m *MarkdownRenderer) WriteToFile(path string) (err error) {
+	directoryPath := filepath.Dir(path)
+	if err = os.MkdirAll(directoryPath, 0755); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	if err := writeCategoryChart(m.Context, directoryPath); err != nil {
 		return pkgerrors.WithStack(err)
 	}
+
 	file, err := os.Create(path)
 	if err != nil {
 		return pkgerrors.WithStack(err)
@@ -209,7 +212,7 @@ func (m Markdown) WriteToFile(path string) (err error) {
 		}
 	}()
 
-	if err := m.format(file, filepath.Dir(path)); err != nil {
+	if err := m.Render(file, m.Context); err != nil {
 		return pkgerrors.WithStack(err)
 	}
 
@@ -0,0 +1,52 @@
+package report
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarkdownTemplateFuncsPercent(t *testing.T) {
+	percent := MarkdownTemplateFuncs["percent"].(func(uint64, uint64) string)
+
+	assert.Equal(t, "50.0%", percent(5, 10))
+	assert.Equal(t, "0.0%", percent(0, 10))
+	assert.Equal(t, "0.0%", percent(5, 0), "a zero total must not divide by zero")
+}
+
+func TestMarkdownTemplateFuncsSortedModels(t *testing.T) {
+	sortedModels := MarkdownTemplateFuncs["sortedModels"].(func([]string) []string)
+
+	input := []string{"zebra", "alpha", "mike"}
+	sorted := sortedModels(input)
+
+	assert.Equal(t, []string{"alpha", "mike", "zebra"}, sorted)
+	assert.Equal(t, []string{"zebra", "alpha", "mike"}, input, "the input slice must not be mutated")
+}
+
+func TestMarkdownRendererUsesCustomTemplate(t *testing.T) {
+	templatePath := filepath.Join(t.TempDir(), "custom.md.tmpl")
+	require.NoError(t, os.WriteFile(templatePath, []byte("Custom report for {{.Version}}, total score {{.TotalScore}}"), 0644))
+
+	renderer := MarkdownRenderer{TemplatePath: templatePath}
+
+	var buffer bytes.Buffer
+	require.NoError(t, renderer.Render(&buffer, Context{Version: "1.2.3", TotalScore: 42}))
+
+	assert.Equal(t, "Custom report for 1.2.3, total score 42", buffer.String())
+}
+
+func TestMarkdownRendererDefaultTemplate(t *testing.T) {
+	renderer := MarkdownRenderer{}
+
+	var buffer bytes.Buffer
+	require.NoError(t, renderer.Render(&buffer, Context{Version: "1.2.3", Revision: "abc"}))
+
+	rendered := buffer.String()
+	assert.Contains(t, rendered, "`version 1.2.3`")
+	assert.Contains(t, rendered, "`revision abc`")
+}
@@ -0,0 +1,132 @@
+package report
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/image"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/config"
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/wcharczuk/go-chart/v2"
+
+	"github.com/symflower/eval-dev-quality/evaluate/metrics"
+	"github.com/symflower/eval-dev-quality/log"
+)
+
+// PDFRenderer renders an evaluation Context as a paginated PDF, for sharing with stakeholders who do not want to browse the Markdown/log tree. It is selected the same way as the other Renderer implementations, via FormatPDF and NewRenderer; see FormatName for the current state of the "--report-formats pdf" flag wiring.
+type PDFRenderer struct {
+	Context
+}
+
+// barChartModelsPerCategoriesPNG generates the same categorized bar chart as barChartModelsPerCategoriesSVG, but rasterized as a PNG so it can be embedded into a PDF page.
+func barChartModelsPerCategoriesPNG(writer io.Writer, categories []*metrics.AssessmentCategory, modelsPerCategory map[*metrics.AssessmentCategory][]string) (err error) {
+	bars := make([]chart.Value, 0, len(categories))
+	for _, category := range categories {
+		if count := len(modelsPerCategory[category]); count > 0 {
+			bars = append(bars, chart.Value{
+				Label: category.Name,
+				Value: float64(count),
+			})
+		}
+	}
+
+	graph := chart.BarChart{
+		Title: "Models per Category",
+		Bars:  bars,
+		Background: chart.Style{
+			Padding: chart.Box{
+				Top:    60,
+				Bottom: 40,
+			},
+		},
+		Height:   300,
+		Width:    (len(bars) + 2) * 60,
+		BarWidth: 60,
+	}
+
+	if err := graph.Render(chart.PNG, writer); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	return nil
+}
+
+// Render writes the rendered PDF report for the given context to the writer.
+func (p *PDFRenderer) Render(writer io.Writer, context Context) (err error) {
+	document := maroto.New(config.NewBuilder().
+		WithPageNumber().
+		Build())
+
+	document.AddRow(20, text.NewCol(12, fmt.Sprintf("Evaluation from %s", context.DateTime.Format("2006-01-02 15:04:05")), text.NewStyle(16)))
+	document.AddRow(10, text.NewCol(12, fmt.Sprintf("Version %s - revision %s", context.Version, context.Revision)))
+
+	modelsPerCategory := context.modelsPerCategory()
+	if len(context.AssessmentPerModel) > 0 {
+		var chartPNG bytes.Buffer
+		if err := barChartModelsPerCategoriesPNG(&chartPNG, metrics.AllAssessmentCategories, modelsPerCategory); err != nil {
+			return pkgerrors.WithStack(err)
+		}
+
+		document.AddRow(120, image.NewFromBytesCol(12, chartPNG.Bytes(), extension.Png))
+	}
+
+	for _, category := range metrics.AllAssessmentCategories {
+		models := modelsPerCategory[category]
+		if len(models) == 0 {
+			continue
+		}
+
+		document.AddRow(12, text.NewCol(12, fmt.Sprintf("Result category %q", category.Name), text.NewStyle(12)))
+		document.AddRow(8, text.NewCol(12, category.Description))
+		for _, model := range models {
+			modelLogPath := filepath.Join(context.ModelLogsPath, log.CleanModelNameForFileSystem(model))
+			score := context.AssessmentPerModel[model].Score()
+			var percent float64
+			if context.TotalScore > 0 {
+				percent = float64(score) / float64(context.TotalScore) * 100
+			}
+
+			document.AddRow(8, text.NewCol(12, fmt.Sprintf("%s - %d/%d (%.1f%%) - %s", model, score, context.TotalScore, percent, modelLogPath)))
+		}
+	}
+
+	pdf, err := document.Generate()
+	if err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	if _, err := writer.Write(pdf.GetBytes()); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	return nil
+}
+
+// WriteToFile renders the PDF and writes it to the given file.
+func (p *PDFRenderer) WriteToFile(path string) (err error) {
+	directoryPath := filepath.Dir(path)
+	if err = os.MkdirAll(directoryPath, 0755); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	if err := writeCategoryChart(p.Context, directoryPath); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	var buffer bytes.Buffer
+	if err := p.Render(&buffer, p.Context); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	if err := os.WriteFile(path, buffer.Bytes(), 0644); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	return nil
+}
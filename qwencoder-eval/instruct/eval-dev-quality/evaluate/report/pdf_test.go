@@ -0,0 +1,33 @@
+package report
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/symflower/eval-dev-quality/evaluate/metrics"
+)
+
+func TestBarChartModelsPerCategoriesPNG(t *testing.T) {
+	category := &metrics.AssessmentCategory{Name: "gold", Description: "gold category"}
+
+	var buffer bytes.Buffer
+	err := barChartModelsPerCategoriesPNG(&buffer, []*metrics.AssessmentCategory{category}, map[*metrics.AssessmentCategory][]string{
+		category: {"model-a", "model-b"},
+	})
+	require.NoError(t, err)
+
+	// PNG files start with an 8-byte signature; this is enough to check the right renderer ran without depending on
+	// exact pixel output.
+	pngSignature := []byte{0x89, 0x50, 0x4e, 0x47, 0x0d, 0x0a, 0x1a, 0x0a}
+	assert.True(t, bytes.HasPrefix(buffer.Bytes(), pngSignature))
+}
+
+func TestPDFRendererRenderEmptyContext(t *testing.T) {
+	var buffer bytes.Buffer
+	err := (&PDFRenderer{}).Render(&buffer, Context{})
+	require.NoError(t, err)
+	assert.NotEmpty(t, buffer.Bytes())
+}
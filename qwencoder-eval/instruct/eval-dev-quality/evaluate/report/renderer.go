@@ -0,0 +1,202 @@
+package report
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/symflower/eval-dev-quality/evaluate/metrics"
+)
+
+// Context holds the data needed to render an evaluation report, independent of the concrete output format.
+type Context struct {
+	// DateTime holds the timestamp of the evaluation.
+	DateTime time.Time
+	// Version holds the version of the evaluation tool.
+	Version string
+	// Revision holds the Git revision of the evaluation tool.
+	Revision string
+
+	// CSVPath holds the path of detailed CSV results.
+	CSVPath string
+	// LogPaths holds the path of detailed logs.
+	LogPaths []string
+	// ModelLogsPath holds the path of the model logs.
+	ModelLogsPath string
+	// SVGPath holds the path of the charted results.
+	SVGPath string
+	// PerModelChartPath holds the path of the per-model total score chart, relative to the report file. Left empty, the chart is not rendered.
+	PerModelChartPath string
+	// StackedChartPath holds the path of the per-model assessment-key breakdown chart, relative to the report file. Left empty, the chart is not rendered.
+	StackedChartPath string
+	// HeatmapChartPath holds the path of the models×assessment-keys heatmap chart, relative to the report file. Left empty, the chart is not rendered.
+	HeatmapChartPath string
+
+	// AssessmentPerModel holds a collection of assessments per model.
+	AssessmentPerModel AssessmentPerModel
+	// TotalScore holds the total reachable score per task.
+	TotalScore uint64
+}
+
+// modelsPerCategory buckets the context's models by the category their assessment falls into.
+func (c Context) modelsPerCategory() map[*metrics.AssessmentCategory][]string {
+	modelsPerCategory := make(map[*metrics.AssessmentCategory][]string, len(metrics.AllAssessmentCategories))
+	for model, assessment := range c.AssessmentPerModel {
+		category := assessment.Category(c.TotalScore)
+		modelsPerCategory[category] = append(modelsPerCategory[category], model)
+	}
+
+	return modelsPerCategory
+}
+
+// Renderer renders an evaluation Context into a specific report output format.
+type Renderer interface {
+	// Render writes the rendered report for the given context to the writer.
+	Render(writer io.Writer, context Context) (err error)
+	// WriteToFile renders the report and writes it to the given file path.
+	WriteToFile(path string) (err error)
+}
+
+// FormatName identifies a report output format, selectable via the "--report-formats" flag.
+//
+// NOTE: this snapshot of the repository does not contain an evaluate/cmd package, so the "--report-formats" flag
+// itself is not registered anywhere yet. Wiring it up means: register a string flag, pass its value through
+// ParseFormats, then call NewRenderer per returned FormatName and WriteToFile each one.
+type FormatName string
+
+const (
+	// FormatMarkdown renders the report as Markdown.
+	FormatMarkdown FormatName = "markdown"
+	// FormatHTML renders the report as a standalone HTML page.
+	FormatHTML FormatName = "html"
+	// FormatAsciiDoc renders the report as AsciiDoc.
+	FormatAsciiDoc FormatName = "asciidoc"
+	// FormatJSON renders the report as machine-consumable JSON.
+	FormatJSON FormatName = "json"
+	// FormatPDF renders the report as a paginated PDF.
+	FormatPDF FormatName = "pdf"
+)
+
+// SupportedFormats holds all report formats that can be requested via "--report-formats".
+var SupportedFormats = []FormatName{FormatMarkdown, FormatHTML, FormatAsciiDoc, FormatJSON, FormatPDF}
+
+// ParseFormats splits and validates a comma-separated "--report-formats" flag value, e.g. "markdown,html,json". This is the piece evaluate/cmd is expected to call when registering that flag; this package does not itself own flag parsing or registration.
+func ParseFormats(value string) (formats []FormatName, err error) {
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		format := FormatName(name)
+		supported := false
+		for _, candidate := range SupportedFormats {
+			if candidate == format {
+				supported = true
+
+				break
+			}
+		}
+		if !supported {
+			return nil, pkgerrors.Errorf("unsupported report format %q, supported formats are %v", format, SupportedFormats)
+		}
+
+		formats = append(formats, format)
+	}
+
+	if len(formats) == 0 {
+		return nil, pkgerrors.Errorf("no report format given, supported formats are %v", SupportedFormats)
+	}
+
+	return formats, nil
+}
+
+// NewRenderer creates the Renderer for the given format, pre-populated with the given context.
+func NewRenderer(format FormatName, context Context) (renderer Renderer, err error) {
+	switch format {
+	case FormatMarkdown:
+		return &MarkdownRenderer{Context: context}, nil
+	case FormatHTML:
+		return &HTMLRenderer{Context: context}, nil
+	case FormatAsciiDoc:
+		return &AsciiDocRenderer{Context: context}, nil
+	case FormatJSON:
+		return &JSONRenderer{Context: context}, nil
+	case FormatPDF:
+		return &PDFRenderer{Context: context}, nil
+	default:
+		return nil, pkgerrors.Errorf("unsupported report format %q, supported formats are %v", format, SupportedFormats)
+	}
+}
+
+// writeCategoryChart renders the categorized bar chart SVG, plus each per-model, stacked and heatmap chart whose path is set, for the given context into the given directory, unless there is nothing to chart.
+func writeCategoryChart(context Context, directoryPath string) (err error) {
+	if len(context.AssessmentPerModel) == 0 {
+		return nil
+	}
+
+	svgFile, err := os.Create(filepath.Join(directoryPath, context.SVGPath))
+	if err != nil {
+		return pkgerrors.WithStack(err)
+	}
+	defer func() {
+		if e := svgFile.Close(); e != nil {
+			e = pkgerrors.WithStack(e)
+			if err == nil {
+				err = e
+			} else {
+				err = errors.Join(err, e)
+			}
+		}
+	}()
+
+	if err := barChartModelsPerCategoriesSVG(svgFile, metrics.AllAssessmentCategories, context.modelsPerCategory()); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	charts := Charts{Context: context}
+	for chartPath, writerTo := range map[string]io.WriterTo{
+		context.PerModelChartPath: charts.PerModelScore(),
+		context.StackedChartPath:  charts.StackedCategoryBreakdown(),
+		context.HeatmapChartPath:  charts.Heatmap(),
+	} {
+		if chartPath == "" {
+			continue
+		}
+
+		if err := writeChart(writerTo, filepath.Join(directoryPath, chartPath)); err != nil {
+			return pkgerrors.WithStack(err)
+		}
+	}
+
+	return nil
+}
+
+// writeChart writes the SVG produced by the given io.WriterTo to the given path.
+func writeChart(writerTo io.WriterTo, path string) (err error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return pkgerrors.WithStack(err)
+	}
+	defer func() {
+		if e := file.Close(); e != nil {
+			e = pkgerrors.WithStack(e)
+			if err == nil {
+				err = e
+			} else {
+				err = errors.Join(err, e)
+			}
+		}
+	}()
+
+	if _, err := writerTo.WriteTo(file); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	return nil
+}
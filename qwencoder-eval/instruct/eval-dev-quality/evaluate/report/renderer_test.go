@@ -0,0 +1,63 @@
+package report
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFormats(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected []FormatName
+		wantErr  bool
+	}{
+		{
+			name:     "single format",
+			value:    "markdown",
+			expected: []FormatName{FormatMarkdown},
+		},
+		{
+			name:     "multiple formats with surrounding whitespace",
+			value:    "markdown, html ,json",
+			expected: []FormatName{FormatMarkdown, FormatHTML, FormatJSON},
+		},
+		{
+			name:    "unsupported format",
+			value:   "markdown,yaml",
+			wantErr: true,
+		},
+		{
+			name:    "empty value",
+			value:   "",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			formats, err := ParseFormats(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, formats)
+		})
+	}
+}
+
+func TestNewRenderer(t *testing.T) {
+	for _, format := range SupportedFormats {
+		renderer, err := NewRenderer(format, Context{})
+		require.NoError(t, err)
+		assert.NotNil(t, renderer)
+	}
+
+	_, err := NewRenderer(FormatName("yaml"), Context{})
+	assert.Error(t, err)
+}